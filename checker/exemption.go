@@ -0,0 +1,42 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/config"
+)
+
+// IsExempted reports whether cfg annotates this check as exempted, along with
+// the human-readable explanations backing the exemption. Consumers (the
+// human-readable formatter, the SARIF emitter) can use this to skip or
+// downgrade a check that a maintainer has already reviewed and accepted.
+func (r CheckResult) IsExempted(cfg config.Config) (bool, []string) {
+	reasons := cfg.MatchingReasons(r.Name)
+	if len(reasons) == 0 {
+		return false, nil
+	}
+
+	explanations := make([]string, 0, len(reasons))
+	for _, rg := range reasons {
+		if rg.Explanation == "" {
+			explanations = append(explanations, string(rg.Reason))
+			continue
+		}
+		explanations = append(explanations, fmt.Sprintf("%s: %s", rg.Reason, rg.Explanation))
+	}
+	return true, explanations
+}
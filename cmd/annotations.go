@@ -0,0 +1,26 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+// showAnnotations backs the --show-annotations flag: when set, the
+// human-readable formatter (see print-result in printResult.go) renders
+// exempted checks with their justification (see
+// scorecard.AsStringResultOption.ShowAnnotations) instead of just the score.
+var showAnnotations bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&showAnnotations, "show-annotations", false,
+		"render exempted checks (per scorecard.yml) with their justification alongside the score")
+}
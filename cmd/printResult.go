@@ -0,0 +1,65 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// printResultCmd renders a single JSON2 scorecard result as the same
+// human-readable table the default `scorecard` run prints, honoring the
+// persistent --show-annotations flag registered in annotations.go.
+var printResultCmd = &cobra.Command{
+	Use:   "print-result <result.json>",
+	Short: "Render a JSON2 scorecard result as a human-readable table",
+	Long: `print-result reads a single JSON2 scorecard result and renders it as the
+human-readable table also used by a default scorecard run. With
+--show-annotations, checks exempted via scorecard.yml are rendered with their
+justification instead of just their score.`,
+	Args: cobra.ExactArgs(1),
+	RunE: printResultRunE,
+}
+
+func init() {
+	rootCmd.AddCommand(printResultCmd)
+}
+
+func printResultRunE(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", args[0], err)
+	}
+	defer f.Close()
+
+	result, _, err := scorecard.NewJSONLReader(f).Next()
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", args[0], err)
+	}
+
+	checkDocs, err := docs.Read()
+	if err != nil {
+		return fmt.Errorf("reading check docs: %w", err)
+	}
+
+	return result.AsString(cmd.OutOrStdout(), checkDocs, &scorecard.AsStringResultOption{
+		ShowAnnotations: showAnnotations,
+	})
+}
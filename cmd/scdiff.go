@@ -0,0 +1,177 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var (
+	scdiffFailOnRegression bool
+	scdiffThresholds       []string
+	scdiffFormat           string
+)
+
+// scdiffCmd compares two scorecard JSON2 result files, one-or-many repos per
+// file, and optionally fails CI when a check regresses past a threshold.
+var scdiffCmd = &cobra.Command{
+	Use:   "scdiff <before.json> <after.json>",
+	Short: "Compare two scorecard results and report per-check regressions",
+	Long: `scdiff reads two files of JSON2 scorecard results - each may contain one
+result or many results concatenated as JSON documents - matches them by repo
+name, and prints a structured diff. With --fail-on-regression, scdiff exits
+non-zero if any check's score dropped by more than its configured threshold
+(default: any drop).`,
+	Args: cobra.ExactArgs(2),
+	RunE: scdiffRunE,
+}
+
+func init() {
+	rootCmd.AddCommand(scdiffCmd)
+	scdiffCmd.Flags().BoolVar(&scdiffFailOnRegression, "fail-on-regression", false,
+		"exit non-zero if any check regresses past its threshold")
+	scdiffCmd.Flags().StringArrayVar(&scdiffThresholds, "threshold", nil,
+		"per-check regression threshold, e.g. --threshold Maintained=2 (repeatable)")
+	scdiffCmd.Flags().StringVar(&scdiffFormat, "format", "markdown", "output format: markdown or json")
+}
+
+func scdiffRunE(cmd *cobra.Command, args []string) error {
+	thresholds, err := parseThresholds(scdiffThresholds)
+	if err != nil {
+		return err
+	}
+
+	before, err := loadResultsByRepo(args[0])
+	if err != nil {
+		return fmt.Errorf("loading %s: %w", args[0], err)
+	}
+	after, err := loadResultsByRepo(args[1])
+	if err != nil {
+		return fmt.Errorf("loading %s: %w", args[1], err)
+	}
+
+	checkDocs, err := docs.Read()
+	if err != nil {
+		return fmt.Errorf("reading check docs: %w", err)
+	}
+
+	repos := make([]string, 0, len(after))
+	for repo := range after {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	regressed := false
+	for _, repo := range repos {
+		b, ok := before[repo]
+		if !ok {
+			continue
+		}
+		a := after[repo]
+
+		diff, err := scorecard.Compare(b, a, checkDocs)
+		if err != nil {
+			return fmt.Errorf("comparing %s: %w", repo, err)
+		}
+
+		if err := writeDiff(cmd.OutOrStdout(), &diff, scdiffFormat); err != nil {
+			return err
+		}
+
+		if checkRegressions(diff, thresholds) {
+			regressed = true
+		}
+	}
+
+	if scdiffFailOnRegression && regressed {
+		return fmt.Errorf("scdiff: one or more checks regressed past their threshold")
+	}
+	return nil
+}
+
+func writeDiff(w io.Writer, diff *scorecard.Diff, format string) error {
+	switch format {
+	case "json":
+		return diff.AsJSON(w)
+	case "markdown", "":
+		return diff.AsMarkdown(w)
+	default:
+		return fmt.Errorf("unknown --format %q, want markdown or json", format)
+	}
+}
+
+// checkRegressions returns true if any check's score dropped by more than its
+// configured threshold (default 0, i.e. any drop counts).
+func checkRegressions(diff scorecard.Diff, thresholds map[string]int) bool {
+	regressed := false
+	for _, c := range diff.Checks {
+		threshold := thresholds[c.Name]
+		if c.ScoreDelta < -threshold {
+			regressed = true
+		}
+	}
+	return regressed
+}
+
+func parseThresholds(raw []string) (map[string]int, error) {
+	thresholds := make(map[string]int, len(raw))
+	for _, entry := range raw {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --threshold %q, want CheckName=N", entry)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --threshold %q: %w", entry, err)
+		}
+		thresholds[name] = n
+	}
+	return thresholds, nil
+}
+
+// loadResultsByRepo reads a file containing one or more JSON2 scorecard
+// results concatenated as JSON documents, keyed by repo name.
+func loadResultsByRepo(path string) (map[string]scorecard.Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	results := make(map[string]scorecard.Result)
+	reader := scorecard.NewJSONLReader(f)
+	for {
+		result, _, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse result: %w", err)
+		}
+		results[result.Repo.Name] = result
+	}
+	return results, nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// MatchingReasons returns the ReasonGroups from annotations that apply to the
+// named check, in the order they appear in the config.
+func (c Config) MatchingReasons(checkName string) []ReasonGroup {
+	var reasons []ReasonGroup
+	for _, ann := range c.Annotations {
+		for _, check := range ann.Checks {
+			if check == checkName {
+				reasons = append(reasons, ann.Reasons...)
+				break
+			}
+		}
+	}
+	return reasons
+}
@@ -0,0 +1,319 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// FindingChange describes how a single probe finding moved between two runs.
+type FindingChange string
+
+const (
+	// FindingAdded indicates the finding is only present in the after result.
+	FindingAdded FindingChange = "added"
+	// FindingRemoved indicates the finding is only present in the before result.
+	FindingRemoved FindingChange = "removed"
+	// FindingChanged indicates the finding is present in both but its outcome changed.
+	FindingChanged FindingChange = "changed"
+)
+
+// FindingDiff captures a single probe finding that differs between two runs.
+// Findings are matched by probe name plus location path and starting line, since
+// that pair is stable across re-runs of the same probe against the same commit.
+type FindingDiff struct {
+	Probe         string        `json:"probe"`
+	Path          string        `json:"path,omitempty"`
+	Line          uint          `json:"line,omitempty"`
+	Change        FindingChange `json:"change"`
+	BeforeOutcome string        `json:"beforeOutcome,omitempty"`
+	AfterOutcome  string        `json:"afterOutcome,omitempty"`
+}
+
+// CheckDiff captures the delta for a single check between two scorecard runs.
+type CheckDiff struct {
+	Name        string        `json:"name"`
+	BeforeScore *int          `json:"beforeScore,omitempty"`
+	AfterScore  *int          `json:"afterScore,omitempty"`
+	ScoreDelta  int           `json:"scoreDelta"`
+	Findings    []FindingDiff `json:"findings,omitempty"`
+}
+
+// Diff is the structured result of comparing two Results for the same repo.
+type Diff struct {
+	Repo          string      `json:"repo"`
+	Checks        []CheckDiff `json:"checks"`
+	AddedChecks   []string    `json:"addedChecks,omitempty"`
+	RemovedChecks []string    `json:"removedChecks,omitempty"`
+	// AggregateScoreDelta is after's weighted aggregate score (per
+	// GetAggregateScore, using checkDocs' per-check weights) minus before's.
+	AggregateScoreDelta float64 `json:"aggregateScoreDelta"`
+	// AverageCheckScoreDelta is the unweighted mean of ScoreDelta across checks
+	// present in both runs. Unlike AggregateScoreDelta it ignores check
+	// weights, but breaks down evenly even when a check's weight isn't known
+	// to the caller.
+	AverageCheckScoreDelta float64 `json:"averageCheckScoreDelta"`
+}
+
+// Normalize zeroes out fields that vary between otherwise-identical runs (the
+// analysis date and the commit SHAs being analyzed) so that comparing two
+// Results, or hashing/serializing one for storage, is stable across runs.
+func Normalize(r *Result) {
+	var zero time.Time
+	r.Date = zero
+	r.Repo.CommitSHA = ""
+	r.Scorecard.CommitSHA = ""
+}
+
+// Compare produces a Diff describing how after differs from before for the
+// same repo. Checks are matched by name; findings within a matched check are
+// matched by probe name plus location path and starting line. before and
+// after are normalized first (see Normalize) so that a diff between two runs
+// of the same commit reports no changes (every check with a zero delta and no
+// finding changes) rather than churning on the analysis date.
+// checkDocs supplies the per-check weights needed to compute the real
+// scorecard aggregate score on each side.
+func Compare(before, after Result, checkDocs docs.Doc) (Diff, error) {
+	if before.Repo.Name != after.Repo.Name {
+		return Diff{}, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("Compare: repo mismatch: %q vs %q", before.Repo.Name, after.Repo.Name))
+	}
+
+	Normalize(&before)
+	Normalize(&after)
+
+	beforeAggregate, err := before.GetAggregateScore(checkDocs)
+	if err != nil {
+		return Diff{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("before.GetAggregateScore: %v", err))
+	}
+	afterAggregate, err := after.GetAggregateScore(checkDocs)
+	if err != nil {
+		return Diff{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("after.GetAggregateScore: %v", err))
+	}
+
+	diff := Diff{
+		Repo:                after.Repo.Name,
+		AggregateScoreDelta: afterAggregate - beforeAggregate,
+	}
+
+	beforeChecks := make(map[string]checker.CheckResult, len(before.Checks))
+	for _, c := range before.Checks {
+		beforeChecks[c.Name] = c
+	}
+	afterChecks := make(map[string]checker.CheckResult, len(after.Checks))
+	for _, c := range after.Checks {
+		afterChecks[c.Name] = c
+	}
+
+	names := make(map[string]bool, len(beforeChecks)+len(afterChecks))
+	for name := range beforeChecks {
+		names[name] = true
+	}
+	for name := range afterChecks {
+		names[name] = true
+	}
+
+	var totalDelta float64
+	var matched int
+	for name := range names {
+		b, inBefore := beforeChecks[name]
+		a, inAfter := afterChecks[name]
+
+		switch {
+		case !inBefore:
+			diff.AddedChecks = append(diff.AddedChecks, name)
+		case !inAfter:
+			diff.RemovedChecks = append(diff.RemovedChecks, name)
+		}
+
+		cd := CheckDiff{Name: name}
+		if inBefore {
+			s := b.Score
+			cd.BeforeScore = &s
+		}
+		if inAfter {
+			s := a.Score
+			cd.AfterScore = &s
+		}
+		if inBefore && inAfter {
+			cd.ScoreDelta = a.Score - b.Score
+			totalDelta += float64(cd.ScoreDelta)
+			matched++
+		}
+		cd.Findings = compareFindings(b, a)
+
+		diff.Checks = append(diff.Checks, cd)
+	}
+
+	sort.Slice(diff.Checks, func(i, j int) bool { return diff.Checks[i].Name < diff.Checks[j].Name })
+	sort.Strings(diff.AddedChecks)
+	sort.Strings(diff.RemovedChecks)
+
+	if matched > 0 {
+		diff.AverageCheckScoreDelta = totalDelta / float64(matched)
+	}
+
+	return diff, nil
+}
+
+// detailFindings extracts the probe findings backing a check's details.
+// Not every detail carries one (freeform log lines don't), so nils are skipped.
+func detailFindings(cr checker.CheckResult) []finding.Finding {
+	var findings []finding.Finding
+	for _, d := range cr.Details {
+		if d.Msg.Finding != nil {
+			findings = append(findings, *d.Msg.Finding)
+		}
+	}
+	return findings
+}
+
+func compareFindings(before, after checker.CheckResult) []FindingDiff {
+	key := func(f finding.Finding) string {
+		line := uint(0)
+		path := ""
+		if f.Location != nil {
+			path = f.Location.Path
+			if f.Location.LineStart != nil {
+				line = *f.Location.LineStart
+			}
+		}
+		return fmt.Sprintf("%s\x00%s\x00%d", f.Probe, path, line)
+	}
+
+	beforeFindings := detailFindings(before)
+	afterFindings := detailFindings(after)
+
+	beforeByKey := make(map[string]finding.Finding, len(beforeFindings))
+	for _, f := range beforeFindings {
+		beforeByKey[key(f)] = f
+	}
+	afterByKey := make(map[string]finding.Finding, len(afterFindings))
+	for _, f := range afterFindings {
+		afterByKey[key(f)] = f
+	}
+
+	keys := make(map[string]bool, len(beforeByKey)+len(afterByKey))
+	for k := range beforeByKey {
+		keys[k] = true
+	}
+	for k := range afterByKey {
+		keys[k] = true
+	}
+
+	var findings []FindingDiff
+	for k := range keys {
+		b, inBefore := beforeByKey[k]
+		a, inAfter := afterByKey[k]
+
+		fd := FindingDiff{}
+		switch {
+		case !inBefore:
+			fd.Probe, fd.Path, fd.Line = a.Probe, locationPath(a), locationLine(a)
+			fd.Change = FindingAdded
+			fd.AfterOutcome = string(a.Outcome)
+		case !inAfter:
+			fd.Probe, fd.Path, fd.Line = b.Probe, locationPath(b), locationLine(b)
+			fd.Change = FindingRemoved
+			fd.BeforeOutcome = string(b.Outcome)
+		case b.Outcome != a.Outcome:
+			fd.Probe, fd.Path, fd.Line = a.Probe, locationPath(a), locationLine(a)
+			fd.Change = FindingChanged
+			fd.BeforeOutcome = string(b.Outcome)
+			fd.AfterOutcome = string(a.Outcome)
+		default:
+			continue
+		}
+		findings = append(findings, fd)
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Probe != findings[j].Probe {
+			return findings[i].Probe < findings[j].Probe
+		}
+		return findings[i].Path < findings[j].Path
+	})
+
+	return findings
+}
+
+func locationPath(f finding.Finding) string {
+	if f.Location == nil {
+		return ""
+	}
+	return f.Location.Path
+}
+
+func locationLine(f finding.Finding) uint {
+	if f.Location == nil || f.Location.LineStart == nil {
+		return 0
+	}
+	return *f.Location.LineStart
+}
+
+// AsJSON writes the diff as JSON.
+func (d *Diff) AsJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	if err := encoder.Encode(d); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
+
+// AsMarkdown writes the diff as a human-readable markdown table, suitable for
+// posting as a PR comment.
+func (d *Diff) AsMarkdown(writer io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "### Scorecard diff for `%s`\n\n", d.Repo)
+	fmt.Fprintf(&sb, "Aggregate score delta: **%+.1f**  \n", d.AggregateScoreDelta)
+	fmt.Fprintf(&sb, "Average check score delta: **%+.1f**\n\n", d.AverageCheckScoreDelta)
+
+	if len(d.AddedChecks) > 0 {
+		fmt.Fprintf(&sb, "Added checks: %s\n\n", strings.Join(d.AddedChecks, ", "))
+	}
+	if len(d.RemovedChecks) > 0 {
+		fmt.Fprintf(&sb, "Removed checks: %s\n\n", strings.Join(d.RemovedChecks, ", "))
+	}
+
+	sb.WriteString("| Check | Before | After | Delta | Findings |\n")
+	sb.WriteString("|---|---|---|---|---|\n")
+	for _, c := range d.Checks {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %+d | %d |\n",
+			c.Name, scorePtrString(c.BeforeScore), scorePtrString(c.AfterScore), c.ScoreDelta, len(c.Findings))
+	}
+
+	_, err := writer.Write([]byte(sb.String()))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("writer.Write: %v", err))
+	}
+	return nil
+}
+
+func scorePtrString(s *int) string {
+	if s == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *s)
+}
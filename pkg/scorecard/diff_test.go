@@ -0,0 +1,189 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func findingWithLine(probe, path string, line uint, outcome finding.Outcome) finding.Finding {
+	l := line
+	return finding.Finding{
+		Probe:   probe,
+		Message: probe + " message",
+		Outcome: outcome,
+		Location: &finding.Location{
+			Path:      path,
+			LineStart: &l,
+		},
+	}
+}
+
+func detailFor(f finding.Finding) checker.CheckDetail {
+	ff := f
+	return checker.CheckDetail{Msg: checker.LogMessage{Text: f.Message, Finding: &ff}}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	unchanged := findingWithLine("archived", "", 0, finding.OutcomeFalse)
+	removedFinding := findingWithLine("binaryArtifacts", "bin/old", 10, finding.OutcomeTrue)
+	beforeChanged := findingWithLine("hasDangerousWorkflowScriptInjection", "workflow.yml", 5, finding.OutcomeFalse)
+	afterChanged := findingWithLine("hasDangerousWorkflowScriptInjection", "workflow.yml", 5, finding.OutcomeTrue)
+	addedFinding := findingWithLine("binaryArtifacts", "bin/new", 20, finding.OutcomeTrue)
+
+	before := Result{
+		Repo: RepoInfo{Name: "github.com/ossf/scorecard", CommitSHA: "before-sha"},
+		Checks: []checker.CheckResult{
+			{
+				Name: "Binary-Artifacts", Score: 7,
+				Details: []checker.CheckDetail{detailFor(unchanged), detailFor(removedFinding)},
+			},
+			{
+				Name: "Dangerous-Workflow", Score: 8,
+				Details: []checker.CheckDetail{detailFor(beforeChanged)},
+			},
+			{
+				Name: "Only-In-Before", Score: 5,
+			},
+		},
+	}
+
+	after := Result{
+		Repo: RepoInfo{Name: "github.com/ossf/scorecard", CommitSHA: "after-sha"},
+		Checks: []checker.CheckResult{
+			{
+				Name: "Binary-Artifacts", Score: 9,
+				Details: []checker.CheckDetail{detailFor(unchanged), detailFor(addedFinding)},
+			},
+			{
+				Name: "Dangerous-Workflow", Score: 6,
+				Details: []checker.CheckDetail{detailFor(afterChanged)},
+			},
+			{
+				Name: "Only-In-After", Score: 10,
+			},
+		},
+	}
+
+	diff, err := Compare(before, after, fakeDoc{})
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+
+	if got, want := diff.AddedChecks, []string{"Only-In-After"}; !equalStrings(got, want) {
+		t.Errorf("AddedChecks = %v, want %v", got, want)
+	}
+	if got, want := diff.RemovedChecks, []string{"Only-In-Before"}; !equalStrings(got, want) {
+		t.Errorf("RemovedChecks = %v, want %v", got, want)
+	}
+
+	checksByName := make(map[string]CheckDiff, len(diff.Checks))
+	for _, c := range diff.Checks {
+		checksByName[c.Name] = c
+	}
+
+	ba, ok := checksByName["Binary-Artifacts"]
+	if !ok {
+		t.Fatalf("Binary-Artifacts missing from diff.Checks")
+	}
+	if ba.ScoreDelta != 2 {
+		t.Errorf("Binary-Artifacts ScoreDelta = %d, want 2", ba.ScoreDelta)
+	}
+
+	var sawRemoved, sawAdded bool
+	for _, fd := range ba.Findings {
+		switch {
+		case fd.Probe == "binaryArtifacts" && fd.Path == "bin/old" && fd.Line == 10:
+			sawRemoved = true
+			if fd.Change != FindingRemoved {
+				t.Errorf("bin/old finding: Change = %q, want %q", fd.Change, FindingRemoved)
+			}
+		case fd.Probe == "binaryArtifacts" && fd.Path == "bin/new" && fd.Line == 20:
+			sawAdded = true
+			if fd.Change != FindingAdded {
+				t.Errorf("bin/new finding: Change = %q, want %q", fd.Change, FindingAdded)
+			}
+		case fd.Path == "" && fd.Probe == "archived":
+			t.Errorf("unchanged finding %+v should not appear in the diff", fd)
+		}
+	}
+	if !sawRemoved {
+		t.Errorf("Binary-Artifacts: did not find the removed bin/old finding in %+v", ba.Findings)
+	}
+	if !sawAdded {
+		t.Errorf("Binary-Artifacts: did not find the added bin/new finding in %+v", ba.Findings)
+	}
+
+	dw, ok := checksByName["Dangerous-Workflow"]
+	if !ok {
+		t.Fatalf("Dangerous-Workflow missing from diff.Checks")
+	}
+	if len(dw.Findings) != 1 {
+		t.Fatalf("Dangerous-Workflow.Findings = %+v, want exactly 1 changed finding", dw.Findings)
+	}
+	if dw.Findings[0].Change != FindingChanged {
+		t.Errorf("Dangerous-Workflow finding Change = %q, want %q", dw.Findings[0].Change, FindingChanged)
+	}
+	if dw.Findings[0].Path != "workflow.yml" || dw.Findings[0].Line != 5 {
+		t.Errorf("Dangerous-Workflow finding path/line = %s:%d, want workflow.yml:5",
+			dw.Findings[0].Path, dw.Findings[0].Line)
+	}
+
+	wantMatchedDelta := float64((9-7)+(6-8)) / 2
+	if diff.AverageCheckScoreDelta != wantMatchedDelta {
+		t.Errorf("AverageCheckScoreDelta = %v, want %v", diff.AverageCheckScoreDelta, wantMatchedDelta)
+	}
+
+	beforeAggregate, err := before.GetAggregateScore(fakeDoc{})
+	if err != nil {
+		t.Fatalf("before.GetAggregateScore: %v", err)
+	}
+	afterAggregate, err := after.GetAggregateScore(fakeDoc{})
+	if err != nil {
+		t.Fatalf("after.GetAggregateScore: %v", err)
+	}
+	if want := afterAggregate - beforeAggregate; diff.AggregateScoreDelta != want {
+		t.Errorf("AggregateScoreDelta = %v, want %v (after.GetAggregateScore - before.GetAggregateScore)",
+			diff.AggregateScoreDelta, want)
+	}
+}
+
+func TestCompareRepoMismatch(t *testing.T) {
+	t.Parallel()
+
+	before := Result{Repo: RepoInfo{Name: "github.com/ossf/scorecard"}}
+	after := Result{Repo: RepoInfo{Name: "github.com/ossf/other-repo"}}
+
+	if _, err := Compare(before, after, fakeDoc{}); err == nil {
+		t.Fatal("Compare: want error on repo name mismatch, got nil")
+	}
+}
+
+func equalStrings(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
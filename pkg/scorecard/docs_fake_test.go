@@ -0,0 +1,51 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"fmt"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+)
+
+// fakeCheckDoc is a minimal docs.CheckDoc used by tests that need to format a
+// Result without loading the real embedded checks.yaml.
+type fakeCheckDoc struct {
+	name string
+}
+
+func (f fakeCheckDoc) GetName() string          { return f.name }
+func (f fakeCheckDoc) GetRisk() string          { return "High" }
+func (f fakeCheckDoc) GetShort() string         { return f.name + " short description" }
+func (f fakeCheckDoc) GetDescription() string   { return f.name + " long description" }
+func (f fakeCheckDoc) GetTags() []string        { return nil }
+func (f fakeCheckDoc) GetRemediation() []string { return nil }
+func (f fakeCheckDoc) GetDocumentationURL(commitish string) string {
+	return "https://example.com/checks#" + f.name
+}
+
+// fakeDoc is a minimal docs.Doc that knows about whatever check names are
+// asked for, so tests don't need to keep it in sync with checks.yaml.
+type fakeDoc struct{}
+
+func (fakeDoc) GetChecks() []docs.CheckDoc   { return nil }
+func (fakeDoc) CheckExists(name string) bool { return true }
+
+func (fakeDoc) GetCheck(name string) (docs.CheckDoc, error) {
+	if name == "" {
+		return nil, fmt.Errorf("fakeDoc: empty check name")
+	}
+	return fakeCheckDoc{name: name}, nil
+}
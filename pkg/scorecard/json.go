@@ -19,11 +19,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/config"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
 	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/log"
 )
 
@@ -47,6 +50,13 @@ type jsonCheckDocumentationV2 struct {
 	// Can be extended if needed.
 }
 
+// jsonAnnotationV2 is a single maintainer-supplied exemption reason, parsed
+// from a repo-local scorecard.yml, that applies to a check result.
+type jsonAnnotationV2 struct {
+	Reason      string `json:"reason"`
+	Explanation string `json:"explanation,omitempty"`
+}
+
 //nolint:govet
 type jsonCheckResultV2 struct {
 	Details     []string                 `json:"details"`
@@ -54,7 +64,8 @@ type jsonCheckResultV2 struct {
 	Reason      string                   `json:"reason"`
 	Name        string                   `json:"name"`
 	Doc         jsonCheckDocumentationV2 `json:"documentation"`
-	Annotations []string                 `json:"annotations,omitempty"`
+	Annotations []jsonAnnotationV2       `json:"annotations,omitempty"`
+	Probes      []finding.Finding        `json:"probes,omitempty"`
 }
 
 type jsonRepoV2 struct {
@@ -93,6 +104,10 @@ type AsJSON2ResultOption struct {
 	LogLevel    log.Level
 	Details     bool
 	Annotations bool
+	// Probes, when set, emits the raw probe findings backing each check's
+	// details in a "probes" field, so downstream tools can reason about
+	// individual probe outcomes rather than reparsing human-readable strings.
+	Probes bool
 }
 
 // AsJSON exports results as JSON for new detail format.
@@ -185,7 +200,15 @@ func (r *Result) resultsToJSON2(checkDocs docs.Doc, opt *AsJSON2ResultOption) (J
 			}
 		}
 		if opt.Annotations {
-			tmpResult.Annotations = append(tmpResult.Annotations, checkResult.Annotations(r.Config)...)
+			for _, rg := range r.Config.MatchingReasons(checkResult.Name) {
+				tmpResult.Annotations = append(tmpResult.Annotations, jsonAnnotationV2{
+					Reason:      string(rg.Reason),
+					Explanation: rg.Explanation,
+				})
+			}
+		}
+		if opt.Probes {
+			tmpResult.Probes = detailFindings(checkResult)
 		}
 		out.Checks = append(out.Checks, tmpResult)
 	}
@@ -249,8 +272,81 @@ func ExperimentalFromJSON2(r io.Reader) (result Result, score float64, err error
 		for _, detail := range check.Details {
 			cr.Details = append(cr.Details, stringToDetail(detail))
 		}
+		if len(check.Probes) > 0 {
+			cr.Details = rehydrateProbeFindings(cr.Details, check.Probes)
+		}
+		if len(check.Annotations) > 0 {
+			ann := config.Annotation{Checks: []string{check.Name}}
+			for _, a := range check.Annotations {
+				ann.Reasons = append(ann.Reasons, config.ReasonGroup{
+					Reason:      config.Reason(a.Reason),
+					Explanation: a.Explanation,
+				})
+			}
+			sr.Config.Annotations = append(sr.Config.Annotations, ann)
+		}
 		sr.Checks = append(sr.Checks, cr)
 	}
 
 	return sr, float64(jsr.AggregateScore), nil
 }
+
+// rehydrateProbeFindings reattaches the original finding.Finding to whichever
+// CheckDetail it was derived from, so the structured probe outcome (location,
+// remediation, values) survives a JSON2 round-trip instead of only the
+// flattened human-readable string.
+//
+// Details and probes can't be paired by slice position: the Details option
+// drops lines below the configured log level, and freeform log lines (with no
+// backing finding) don't appear in probes at all, so the two slices diverge
+// in length and order once both options are in play. They also can't be
+// paired by exact text equality: DetailToString decorates Msg.Text with a
+// level prefix and, for located findings, a trailing "path:line" that isn't
+// part of finding.Message. Instead, a probe is matched to the detail whose
+// text *contains* its message (and its location's path, when it has one) -
+// those substrings survive whatever decoration was added around them - and
+// each probe is consumed at most once so repeated messages don't double-pair.
+// A probe with no matching detail (e.g. Details was omitted, or its line was
+// filtered by log level) is appended as a new detail rather than dropped, and
+// every existing detail's Type is left untouched.
+func rehydrateProbeFindings(details []checker.CheckDetail, probes []finding.Finding) []checker.CheckDetail {
+	used := make([]bool, len(probes))
+	for i := range details {
+		for j := range probes {
+			if used[j] || !probeMatchesDetailText(probes[j], details[i].Msg.Text) {
+				continue
+			}
+			f := probes[j]
+			details[i].Msg.Finding = &f
+			used[j] = true
+			break
+		}
+	}
+
+	for j := range probes {
+		if used[j] {
+			continue
+		}
+		f := probes[j]
+		details = append(details, checker.CheckDetail{
+			Msg: checker.LogMessage{
+				Text:    f.Message,
+				Finding: &f,
+			},
+		})
+	}
+	return details
+}
+
+// probeMatchesDetailText reports whether text could have been produced by
+// DetailToString for f: f's message must appear verbatim somewhere in text,
+// and if f has a location, so must its path.
+func probeMatchesDetailText(f finding.Finding, text string) bool {
+	if f.Message == "" || !strings.Contains(text, f.Message) {
+		return false
+	}
+	if f.Location != nil && f.Location.Path != "" && !strings.Contains(text, f.Location.Path) {
+		return false
+	}
+	return true
+}
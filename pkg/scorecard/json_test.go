@@ -0,0 +1,109 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// TestJSON2ProbeRoundTrip verifies that a Result emitted with both Details and
+// Probes, then read back via ExperimentalFromJSON2, keeps the same number of
+// details and reattaches each detail's backing finding - it must not append
+// probes as spurious duplicate details just because DetailToString decorated
+// their text with a level prefix and a trailing "path:line".
+func TestJSON2ProbeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	line := uint(42)
+	before := Result{
+		Repo:      RepoInfo{Name: "github.com/ossf/scorecard", CommitSHA: "abc123"},
+		Scorecard: ScorecardInfo{Version: "v5.0.0", CommitSHA: "def456"},
+		Date:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Checks: []checker.CheckResult{
+			{
+				Name:   "Binary-Artifacts",
+				Score:  3,
+				Reason: "binaries present in source",
+				Details: []checker.CheckDetail{
+					{
+						Type: checker.DetailWarn,
+						Msg: checker.LogMessage{
+							Text: "binary artifact found",
+							Finding: &finding.Finding{
+								Probe:   "binaryArtifacts",
+								Message: "binary artifact found",
+								Outcome: finding.OutcomeTrue,
+								Location: &finding.Location{
+									Path:      "bin/tool",
+									LineStart: &line,
+								},
+							},
+						},
+					},
+					{
+						Type: checker.DetailInfo,
+						Msg: checker.LogMessage{
+							Text: "no other binaries found",
+							Finding: &finding.Finding{
+								Probe:   "binaryArtifacts",
+								Message: "no other binaries found",
+								Outcome: finding.OutcomeFalse,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	opt := &AsJSON2ResultOption{Details: true, Probes: true}
+	if err := before.AsJSON2(&buf, fakeDoc{}, opt); err != nil {
+		t.Fatalf("AsJSON2: %v", err)
+	}
+
+	after, _, err := ExperimentalFromJSON2(&buf)
+	if err != nil {
+		t.Fatalf("ExperimentalFromJSON2: %v", err)
+	}
+
+	if len(after.Checks) != 1 {
+		t.Fatalf("got %d checks, want 1", len(after.Checks))
+	}
+	got := after.Checks[0].Details
+	want := before.Checks[0].Details
+	if len(got) != len(want) {
+		t.Fatalf("got %d details, want %d (probes were appended as duplicates instead of reattached): %+v",
+			len(got), len(want), got)
+	}
+
+	for i, d := range got {
+		if d.Msg.Finding == nil {
+			t.Errorf("detail %d (%q): Msg.Finding not reattached", i, d.Msg.Text)
+			continue
+		}
+		if d.Msg.Finding.Message != want[i].Msg.Finding.Message {
+			t.Errorf("detail %d: Finding.Message = %q, want %q", i, d.Msg.Finding.Message, want[i].Msg.Finding.Message)
+		}
+		if d.Msg.Finding.Outcome != want[i].Msg.Finding.Outcome {
+			t.Errorf("detail %d: Finding.Outcome = %q, want %q", i, d.Msg.Finding.Outcome, want[i].Msg.Finding.Outcome)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// ResultWriter incrementally appends JSONScorecardResultV2 documents to an
+// io.Writer, one per line, so large batch jobs scanning many repos can stream
+// results without holding them all in memory.
+type ResultWriter struct {
+	encoder   *json.Encoder
+	checkDocs docs.Doc
+	opt       *AsJSON2ResultOption
+}
+
+// NewJSONLWriter returns a ResultWriter that writes newline-delimited
+// JSONScorecardResultV2 documents to w.
+func NewJSONLWriter(w io.Writer, checkDocs docs.Doc, opt *AsJSON2ResultOption) *ResultWriter {
+	return &ResultWriter{
+		encoder:   json.NewEncoder(w),
+		checkDocs: checkDocs,
+		opt:       opt,
+	}
+}
+
+// Write appends r to the stream as a single line of JSON.
+func (rw *ResultWriter) Write(r *Result) error {
+	out, err := r.resultsToJSON2(rw.checkDocs, rw.opt)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+	if err := rw.encoder.Encode(out); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
+
+// Close releases any resources held by the writer. The underlying io.Writer
+// is not closed.
+func (rw *ResultWriter) Close() error {
+	return nil
+}
+
+// ResultReader iterates over a stream of JSONScorecardResultV2 documents,
+// whether newline-delimited or simply concatenated one after another, and
+// yields a Result plus its aggregate score for each.
+type ResultReader struct {
+	decoder *json.Decoder
+}
+
+// NewJSONLReader returns a ResultReader over r.
+func NewJSONLReader(r io.Reader) *ResultReader {
+	return &ResultReader{decoder: json.NewDecoder(r)}
+}
+
+// Next returns the next Result and its aggregate score, or io.EOF once the
+// stream is exhausted.
+func (rr *ResultReader) Next() (Result, float64, error) {
+	var raw json.RawMessage
+	if err := rr.decoder.Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Result{}, 0, io.EOF
+		}
+		return Result{}, 0, fmt.Errorf("decode: %w", err)
+	}
+
+	return ExperimentalFromJSON2(bytes.NewReader(raw))
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+// TestJSONLRoundTrip writes several repos' results to one stream with
+// ResultWriter and reads them back with ResultReader, checking that the
+// repos come back in order and with their per-check scores intact.
+func TestJSONLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	results := []Result{
+		{
+			Repo:      RepoInfo{Name: "github.com/ossf/scorecard", CommitSHA: "sha1"},
+			Scorecard: ScorecardInfo{Version: "v5.0.0", CommitSHA: "tool-sha"},
+			Checks:    []checker.CheckResult{{Name: "Binary-Artifacts", Score: 7}},
+		},
+		{
+			Repo:      RepoInfo{Name: "github.com/ossf/criticality_score", CommitSHA: "sha2"},
+			Scorecard: ScorecardInfo{Version: "v5.0.0", CommitSHA: "tool-sha"},
+			Checks:    []checker.CheckResult{{Name: "Dangerous-Workflow", Score: 10}},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf, fakeDoc{}, nil)
+	for i := range results {
+		if err := w.Write(&results[i]); err != nil {
+			t.Fatalf("Write(%d): %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	r := NewJSONLReader(&buf)
+	for i, want := range results {
+		got, _, err := r.Next()
+		if err != nil {
+			t.Fatalf("Next(%d): %v", i, err)
+		}
+		if got.Repo.Name != want.Repo.Name {
+			t.Errorf("result %d: Repo.Name = %q, want %q", i, got.Repo.Name, want.Repo.Name)
+		}
+		if len(got.Checks) != len(want.Checks) || got.Checks[0].Name != want.Checks[0].Name ||
+			got.Checks[0].Score != want.Checks[0].Score {
+			t.Errorf("result %d: Checks = %+v, want %+v", i, got.Checks, want.Checks)
+		}
+	}
+
+	if _, _, err := r.Next(); err != io.EOF {
+		t.Errorf("Next after last result: err = %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// AsStringResultOption provides configuration options for the human-readable
+// formatter.
+type AsStringResultOption struct {
+	// ShowAnnotations renders exempted checks with their justification
+	// alongside the score, instead of just the score.
+	ShowAnnotations bool
+}
+
+// AsString exports results as a human-readable table, one row per check.
+func (r *Result) AsString(writer io.Writer, checkDocs docs.Doc, opt *AsStringResultOption) error {
+	if opt == nil {
+		opt = &AsStringResultOption{}
+	}
+
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Score\tName\tReason\tDocumentation")
+
+	for _, checkResult := range r.Checks {
+		doc, e := checkDocs.GetCheck(checkResult.Name)
+		if e != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", checkResult.Name, e))
+		}
+		if doc == nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", checkResult.Name, errNoDoc))
+		}
+
+		score := fmt.Sprintf("%d", checkResult.Score)
+		reason := checkResult.Reason
+		if opt.ShowAnnotations {
+			if exempted, explanations := checkResult.IsExempted(r.Config); exempted {
+				score = fmt.Sprintf("%d (exempted)", checkResult.Score)
+				reason = strings.Join(explanations, "; ")
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", score, checkResult.Name, reason, doc.GetDocumentationURL(r.Scorecard.CommitSHA))
+	}
+
+	if err := w.Flush(); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("tabwriter.Flush: %v", err))
+	}
+	return nil
+}
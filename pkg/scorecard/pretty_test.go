@@ -0,0 +1,80 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/config"
+)
+
+func exemptedResult() Result {
+	return Result{
+		Config: config.Config{
+			Annotations: []config.Annotation{
+				{
+					Checks: []string{"Binary-Artifacts"},
+					Reasons: []config.ReasonGroup{
+						{Reason: "not_applicable", Explanation: "vendored binaries are test fixtures"},
+					},
+				},
+			},
+		},
+		Checks: []checker.CheckResult{
+			{Name: "Binary-Artifacts", Score: 3, Reason: "binaries present in source"},
+		},
+	}
+}
+
+func TestAsStringShowAnnotations(t *testing.T) {
+	t.Parallel()
+
+	r := exemptedResult()
+	var sb strings.Builder
+	if err := r.AsString(&sb, fakeDoc{}, &AsStringResultOption{ShowAnnotations: true}); err != nil {
+		t.Fatalf("AsString: %v", err)
+	}
+
+	out := sb.String()
+	if !strings.Contains(out, "exempted") {
+		t.Errorf("AsString with ShowAnnotations: want score column to mark the check \"exempted\", got:\n%s", out)
+	}
+	if !strings.Contains(out, "vendored binaries are test fixtures") {
+		t.Errorf("AsString with ShowAnnotations: want explanation in output, got:\n%s", out)
+	}
+	if strings.Contains(out, "binaries present in source") {
+		t.Errorf("AsString with ShowAnnotations: want original reason replaced, got:\n%s", out)
+	}
+}
+
+func TestAsStringWithoutAnnotations(t *testing.T) {
+	t.Parallel()
+
+	r := exemptedResult()
+	var sb strings.Builder
+	if err := r.AsString(&sb, fakeDoc{}, &AsStringResultOption{ShowAnnotations: false}); err != nil {
+		t.Fatalf("AsString: %v", err)
+	}
+
+	out := sb.String()
+	if strings.Contains(out, "exempted") {
+		t.Errorf("AsString without ShowAnnotations: did not expect \"exempted\", got:\n%s", out)
+	}
+	if !strings.Contains(out, "binaries present in source") {
+		t.Errorf("AsString without ShowAnnotations: want original reason, got:\n%s", out)
+	}
+}
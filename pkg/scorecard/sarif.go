@@ -0,0 +1,235 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+//nolint:govet
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+//nolint:govet
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+//nolint:govet
+type sarifDriver struct {
+	Name            string            `json:"name"`
+	InformationURI  string            `json:"informationUri"`
+	SemanticVersion string            `json:"semanticVersion,omitempty"`
+	Properties      *sarifPropertyBag `json:"properties,omitempty"`
+	Rules           []sarifRule       `json:"rules"`
+}
+
+// sarifPropertyBag carries scorecard-specific metadata that SARIF 2.1.0 has
+// no dedicated field for, namely the commit the run analyzed.
+type sarifPropertyBag struct {
+	Commit string `json:"commit,omitempty"`
+}
+
+//nolint:govet
+type sarifRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	FullDescription  sarifMessage `json:"fullDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+//nolint:govet
+type sarifResult struct {
+	RuleID    string             `json:"ruleId"`
+	Level     string             `json:"level"`
+	Message   sarifMessage       `json:"message"`
+	Locations []sarifLocationRef `json:"locations,omitempty"`
+}
+
+type sarifLocationRef struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+//nolint:govet
+type sarifRegion struct {
+	// StartLine is a pointer so a finding with no known line (only a path, or
+	// only a snippet) omits it rather than serializing the invalid "startLine":0.
+	// SARIF 2.1.0 regions are 1-based.
+	StartLine *int          `json:"startLine,omitempty"`
+	Snippet   *sarifMessage `json:"snippet,omitempty"`
+}
+
+// AsSARIFResultOption provides configuration options for SARIF output.
+type AsSARIFResultOption struct {
+	// ErrorThreshold is the minimum check score, inclusive, below which
+	// findings are reported at SARIF level "error". Defaults to 3.
+	ErrorThreshold int
+	// WarningThreshold is the minimum check score, inclusive, below which
+	// findings are reported at SARIF level "warning" (if at or above ErrorThreshold).
+	// Findings for checks scoring at or above WarningThreshold are reported as
+	// "note". Defaults to 7.
+	WarningThreshold int
+}
+
+func (opt *AsSARIFResultOption) errorThreshold() int {
+	if opt == nil || opt.ErrorThreshold == 0 {
+		return 3
+	}
+	return opt.ErrorThreshold
+}
+
+func (opt *AsSARIFResultOption) warningThreshold() int {
+	if opt == nil || opt.WarningThreshold == 0 {
+		return 7
+	}
+	return opt.WarningThreshold
+}
+
+func sarifLevel(score int, opt *AsSARIFResultOption) string {
+	switch {
+	case score < opt.errorThreshold():
+		return "error"
+	case score < opt.warningThreshold():
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// AsSARIF exports results as SARIF 2.1.0, suitable for upload to GitHub code
+// scanning via the `github/codeql-action/upload-sarif` action. One SARIF rule
+// is emitted per check, populated from checkDocs; one SARIF result is emitted
+// per probe finding attached to a check's details.
+func (r *Result) AsSARIF(writer io.Writer, checkDocs docs.Doc, opt *AsSARIFResultOption) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:            "scorecard",
+				InformationURI:  "https://github.com/ossf/scorecard",
+				SemanticVersion: r.Scorecard.Version,
+				Properties:      &sarifPropertyBag{Commit: r.Scorecard.CommitSHA},
+			},
+		},
+	}
+
+	for _, checkResult := range r.Checks {
+		doc, e := checkDocs.GetCheck(checkResult.Name)
+		if e != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", checkResult.Name, e))
+		}
+		if doc == nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %s: %v", checkResult.Name, errNoDoc))
+		}
+
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               checkResult.Name,
+			Name:             checkResult.Name,
+			ShortDescription: sarifMessage{Text: doc.GetShort()},
+			FullDescription:  sarifMessage{Text: doc.GetDescription()},
+			HelpURI:          doc.GetDocumentationURL(r.Scorecard.CommitSHA),
+		})
+
+		level := sarifLevel(checkResult.Score, opt)
+		for _, d := range checkResult.Details {
+			if d.Msg.Finding == nil {
+				continue
+			}
+			run.Results = append(run.Results, sarifResultFromFinding(checkResult.Name, level, *d.Msg.Finding))
+		}
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	encoder := json.NewEncoder(writer)
+	//nolint:musttag
+	if err := encoder.Encode(log); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
+
+func sarifResultFromFinding(checkName, level string, f finding.Finding) sarifResult {
+	text := f.Message
+	if text == "" {
+		text = f.Probe
+	}
+
+	result := sarifResult{
+		RuleID:  checkName,
+		Level:   level,
+		Message: sarifMessage{Text: text},
+	}
+
+	if f.Location == nil {
+		return result
+	}
+
+	var region *sarifRegion
+	if f.Location.LineStart != nil || f.Location.Snippet != nil {
+		region = &sarifRegion{}
+		if f.Location.LineStart != nil {
+			line := int(*f.Location.LineStart)
+			region.StartLine = &line
+		}
+		if f.Location.Snippet != nil {
+			region.Snippet = &sarifMessage{Text: *f.Location.Snippet}
+		}
+	}
+
+	result.Locations = []sarifLocationRef{
+		{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: f.Location.Path},
+				Region:           region,
+			},
+		},
+	}
+
+	return result
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// TestAsSARIFRegionOmittedWithoutLine checks that a path-only finding (no
+// known line and no snippet) serializes without a startLine field - its region
+// is omitted altogether - rather than the invalid "startLine":0 a zero-valued
+// int would produce.
+func TestAsSARIFRegionOmittedWithoutLine(t *testing.T) {
+	t.Parallel()
+
+	r := Result{
+		Scorecard: ScorecardInfo{Version: "v5.0.0", CommitSHA: "abc123"},
+		Checks: []checker.CheckResult{
+			{
+				Name:  "Binary-Artifacts",
+				Score: 3,
+				Details: []checker.CheckDetail{
+					detailFor(finding.Finding{
+						Probe:   "binaryArtifacts",
+						Message: "binary artifact found",
+						Outcome: finding.OutcomeTrue,
+						Location: &finding.Location{
+							Path: "bin/tool",
+						},
+					}),
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := r.AsSARIF(&buf, fakeDoc{}, nil); err != nil {
+		t.Fatalf("AsSARIF: %v", err)
+	}
+
+	if strings.Contains(buf.String(), `"startLine"`) {
+		t.Errorf("AsSARIF: want no startLine field for a path-only finding, got:\n%s", buf.String())
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &raw); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+}
+
+// TestAsSARIFRegionWithLine checks that a finding with a known line does get
+// a 1-based startLine in its region.
+func TestAsSARIFRegionWithLine(t *testing.T) {
+	t.Parallel()
+
+	line := uint(42)
+	r := Result{
+		Scorecard: ScorecardInfo{Version: "v5.0.0", CommitSHA: "abc123"},
+		Checks: []checker.CheckResult{
+			{
+				Name:  "Binary-Artifacts",
+				Score: 3,
+				Details: []checker.CheckDetail{
+					detailFor(finding.Finding{
+						Probe:   "binaryArtifacts",
+						Message: "binary artifact found",
+						Outcome: finding.OutcomeTrue,
+						Location: &finding.Location{
+							Path:      "bin/tool",
+							LineStart: &line,
+						},
+					}),
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := r.AsSARIF(&buf, fakeDoc{}, nil); err != nil {
+		t.Fatalf("AsSARIF: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `"startLine":42`) {
+		t.Errorf("AsSARIF: want startLine:42 for a finding with a known line, got:\n%s", buf.String())
+	}
+}